@@ -0,0 +1,57 @@
+package route53resolver
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_route53_resolver_config", name="Config")
+func DataSourceConfig() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceConfigRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"autodefined_reverse_flag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+const (
+	DSNameConfig = "Config Data Source"
+)
+
+func dataSourceConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53ResolverConn
+
+	resourceID := d.Get("resource_id").(string)
+
+	out, err := FindResolverConfigByID(ctx, conn, resourceID)
+
+	if err != nil {
+		return create.DiagError(names.Route53Resolver, create.ErrActionReading, DSNameConfig, resourceID, err)
+	}
+
+	d.SetId(aws.StringValue(out.Id))
+	d.Set("resource_id", out.ResourceId)
+	d.Set("autodefined_reverse_flag", out.AutodefinedReverseFlag)
+	d.Set("owner_id", out.OwnerId)
+
+	return nil
+}
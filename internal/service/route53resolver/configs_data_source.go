@@ -0,0 +1,119 @@
+package route53resolver
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_route53_resolver_configs", name="Configs")
+func DataSourceConfigs() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceConfigsRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resolver_configs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"autodefined_reverse_flag": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+const (
+	DSNameConfigs = "Configs Data Source"
+)
+
+func dataSourceConfigsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53ResolverConn
+
+	resourceID := d.Get("resource_id").(string)
+	ownerID := d.Get("owner_id").(string)
+
+	var resolverConfigs []*route53resolver.ResolverConfig
+
+	in := &route53resolver.ListResolverConfigsInput{}
+	err := conn.ListResolverConfigsPagesWithContext(ctx, in, func(page *route53resolver.ListResolverConfigsOutput, lastPage bool) bool {
+		for _, c := range page.ResolverConfigs {
+			if resourceID != "" && aws.StringValue(c.ResourceId) != resourceID {
+				continue
+			}
+			if ownerID != "" && aws.StringValue(c.OwnerId) != ownerID {
+				continue
+			}
+
+			resolverConfigs = append(resolverConfigs, c)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return create.DiagError(names.Route53Resolver, create.ErrActionReading, DSNameConfigs, resourceID, err)
+	}
+
+	d.SetId(id.UniqueId())
+	if err := d.Set("resolver_configs", flattenResolverConfigs(resolverConfigs)); err != nil {
+		return diag.Errorf("setting resolver_configs: %s", err)
+	}
+
+	return nil
+}
+
+func flattenResolverConfigs(apiObjects []*route53resolver.ResolverConfig) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"id":                       aws.StringValue(apiObject.Id),
+			"resource_id":              aws.StringValue(apiObject.ResourceId),
+			"owner_id":                 aws.StringValue(apiObject.OwnerId),
+			"autodefined_reverse_flag": aws.StringValue(apiObject.AutodefinedReverseFlag),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,44 @@
+package route53resolver
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+)
+
+func TestFlattenResolverConfigs(t *testing.T) {
+	t.Parallel()
+
+	apiObjects := []*route53resolver.ResolverConfig{
+		{
+			Id:                     aws.String("rc-0123456789"),
+			ResourceId:             aws.String("vpc-0123456789"),
+			OwnerId:                aws.String("123456789012"),
+			AutodefinedReverseFlag: aws.String("ENABLE"),
+		},
+	}
+
+	got := flattenResolverConfigs(apiObjects)
+	if len(got) != 1 {
+		t.Fatalf("flattenResolverConfigs() returned %d entries, want 1", len(got))
+	}
+
+	tfMap := got[0].(map[string]interface{})
+	if tfMap["id"] != "rc-0123456789" {
+		t.Errorf("flattenResolverConfigs()[0][\"id\"] = %v, want rc-0123456789", tfMap["id"])
+	}
+	if tfMap["resource_id"] != "vpc-0123456789" {
+		t.Errorf("flattenResolverConfigs()[0][\"resource_id\"] = %v, want vpc-0123456789", tfMap["resource_id"])
+	}
+	if tfMap["owner_id"] != "123456789012" {
+		t.Errorf("flattenResolverConfigs()[0][\"owner_id\"] = %v, want 123456789012", tfMap["owner_id"])
+	}
+	if tfMap["autodefined_reverse_flag"] != "ENABLE" {
+		t.Errorf("flattenResolverConfigs()[0][\"autodefined_reverse_flag\"] = %v, want ENABLE", tfMap["autodefined_reverse_flag"])
+	}
+
+	if got := flattenResolverConfigs(nil); got != nil {
+		t.Errorf("flattenResolverConfigs(nil) = %v, want nil", got)
+	}
+}
@@ -17,7 +17,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
-	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -44,6 +43,8 @@ func ResourceRegisterTargets() *schema.Resource {
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
+		DeprecationMessage: "use aws_vpclattice_target_group_attachment instead, which manages a single target per resource",
+
 		Schema: map[string]*schema.Schema{
 			"target_group_identifier": {
 				Type:     schema.TypeString,
@@ -54,7 +55,6 @@ func ResourceRegisterTargets() *schema.Resource {
 				Type:     schema.TypeList,
 				Optional: true,
 				ForceNew: true,
-				MaxItems: 1,
 				MinItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -72,14 +72,32 @@ func ResourceRegisterTargets() *schema.Resource {
 					},
 				},
 			},
+			"wait_for_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      waitForStatusHealthy,
+				ValidateFunc: validation.StringInSlice(waitForStatusValues(), false),
+			},
 		},
 	}
 }
 
 const (
 	ResNameRegisterTargets = "Register Targets"
+
+	waitForStatusHealthy = "healthy"
+	waitForStatusAny     = "any"
+	waitForStatusNone    = "none"
+
+	targetsStatusPending  = "PENDING"
+	targetsStatusComplete = "COMPLETE"
+	targetsStatusFound    = "FOUND"
 )
 
+func waitForStatusValues() []string {
+	return []string{waitForStatusHealthy, waitForStatusAny, waitForStatusNone}
+}
+
 func resourceRegisterTargetsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).VPCLatticeClient()
 
@@ -87,16 +105,15 @@ func resourceRegisterTargetsCreate(ctx context.Context, d *schema.ResourceData,
 		TargetGroupIdentifier: aws.String(d.Get("target_group_identifier").(string)),
 	}
 
-	var targetId string
-	if v, ok := d.GetOk("targets"); ok && len(v.([]interface{})) > 0 && v.([]interface{}) != nil {
+	var targetIDs []string
+	if v, ok := d.GetOk("targets"); ok && len(v.([]interface{})) > 0 {
 		targets := expandTargets(v.([]interface{}))
 
-		if len(targets) > 0 {
-			target := targets[0]
+		for _, target := range targets {
 			log.Printf("[INFO] Registering Target %s with Target Group %s", aws.ToString(target.Id), d.Get("target_group_identifier").(string))
-			targetId = *target.Id
-			in.Targets = targets
+			targetIDs = append(targetIDs, aws.ToString(target.Id))
 		}
+		in.Targets = targets
 	}
 
 	out, err := conn.RegisterTargets(ctx, in)
@@ -110,19 +127,32 @@ func resourceRegisterTargetsCreate(ctx context.Context, d *schema.ResourceData,
 
 	targetGroupIdentifier := d.Get("target_group_identifier").(string)
 	targets := d.Get("targets").([]interface{})
+	waitForStatus := d.Get("wait_for_status").(string)
 
 	parts := []string{
-		d.Get("target_group_identifier").(string),
-		targetId,
+		targetGroupIdentifier,
+		strings.Join(targetIDs, ","),
 	}
 
 	d.SetId(strings.Join(parts, "/"))
 
-	if _, err := waitRegisterTargets(ctx, conn, targetGroupIdentifier, targets, d.Timeout(schema.TimeoutCreate)); err != nil {
+	waitOut, err := waitRegisterTargets(ctx, conn, targetGroupIdentifier, targets, waitForStatus, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
 		return create.DiagError(names.VPCLattice, create.ErrActionWaitingForCreation, ResNameRegisterTargets, d.Id(), err)
 	}
 
-	return resourceRegisterTargetsRead(ctx, d, meta)
+	var diags diag.Diagnostics
+	if waitOut != nil {
+		if unhealthy := unhealthyTargetSummaries(waitOut.Items); len(unhealthy) > 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "VpcLattice target(s) not healthy",
+				Detail:   fmt.Sprintf("the following targets did not reach a healthy state: %s", strings.Join(unhealthy, ", ")),
+			})
+		}
+	}
+
+	return append(diags, resourceRegisterTargetsRead(ctx, d, meta)...)
 }
 
 func resourceRegisterTargetsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -203,41 +233,101 @@ func findRegisterTargets(ctx context.Context, conn *vpclattice.Client, targetGro
 	return out, nil
 }
 
-func waitRegisterTargets(ctx context.Context, conn *vpclattice.Client, id string, targets []interface{}, timeout time.Duration) (*vpclattice.RegisterTargetsOutput, error) {
+// targetIsPending reports whether status should still be considered in-flight for a
+// create/register wait with the given wait_for_status. INITIAL is always pending.
+// UNHEALTHY/UNAVAILABLE are only pending for wait_for_status = "healthy", so that
+// mode times out rather than succeeding if a target stays UNHEALTHY/UNAVAILABLE; for
+// "any" those statuses are already terminal. HEALTHY, UNUSED and DRAINING are never
+// pending, in either mode.
+func targetIsPending(waitForStatus string, status types.TargetStatus) bool {
+	switch status {
+	case types.TargetStatusInitial:
+		return true
+	case types.TargetStatusUnhealthy, types.TargetStatusUnavailable:
+		return waitForStatus == waitForStatusHealthy
+	default:
+		return false
+	}
+}
+
+// targetIsPendingDeletion reports whether status should still be considered in-flight
+// while waiting for a target to be deregistered.
+func targetIsPendingDeletion(status types.TargetStatus) bool {
+	switch status {
+	case types.TargetStatusDraining, types.TargetStatusInitial:
+		return true
+	default:
+		return false
+	}
+}
+
+// waitRegisterTargets waits until every target passed to the resource has reached a
+// terminal state. When waitForStatus is "healthy" a target sitting at UNHEALTHY or
+// UNAVAILABLE is treated as still pending, so the wait only succeeds once every target
+// is HEALTHY, UNUSED or DRAINING (or times out). When waitForStatus is "any" the wait is satisfied as soon
+// as every target has left INITIAL, regardless of the state it lands on. A waitForStatus
+// of "none" skips the health poll, but still tolerates ListTargets briefly returning
+// not-found/empty immediately after RegisterTargets, since registration is eventually
+// consistent.
+func waitRegisterTargets(ctx context.Context, conn *vpclattice.Client, id string, targets []interface{}, waitForStatus string, timeout time.Duration) (*vpclattice.ListTargetsOutput, error) {
+	if waitForStatus == waitForStatusNone {
+		stateConf := &retry.StateChangeConf{
+			Pending:        []string{},
+			Target:         []string{targetsStatusFound},
+			Refresh:        statusTargetsFound(ctx, conn, id, targets),
+			Timeout:        timeout,
+			NotFoundChecks: 20,
+		}
+
+		outputRaw, err := stateConf.WaitForStateContext(ctx)
+		if out, ok := outputRaw.(*vpclattice.ListTargetsOutput); ok {
+			return out, err
+		}
+
+		return nil, err
+	}
+
+	isPending := func(status types.TargetStatus) bool {
+		return targetIsPending(waitForStatus, status)
+	}
+
 	stateConf := &retry.StateChangeConf{
-		Pending:                   enum.Slice(types.TargetStatusInitial),
-		Target:                    enum.Slice(types.TargetStatusHealthy, types.TargetStatusUnhealthy, types.TargetStatusUnused, types.TargetStatusUnavailable),
-		Refresh:                   statusTarget(ctx, conn, id, targets),
+		Pending:                   []string{targetsStatusPending},
+		Target:                    []string{targetsStatusComplete},
+		Refresh:                   statusTargets(ctx, conn, id, targets, isPending),
 		Timeout:                   timeout,
 		NotFoundChecks:            20,
 		ContinuousTargetOccurence: 2,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
-	if out, ok := outputRaw.(*vpclattice.RegisterTargetsOutput); ok {
+	if out, ok := outputRaw.(*vpclattice.ListTargetsOutput); ok {
 		return out, err
 	}
 
 	return nil, err
 }
 
-func waitDeleteTargets(ctx context.Context, conn *vpclattice.Client, id string, targets []interface{}, timeout time.Duration) (*vpclattice.DeregisterTargetsOutput, error) {
+func waitDeleteTargets(ctx context.Context, conn *vpclattice.Client, id string, targets []interface{}, timeout time.Duration) (*vpclattice.ListTargetsOutput, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(types.TargetStatusDraining, types.TargetStatusInitial),
+		Pending: []string{targetsStatusPending},
 		Target:  []string{},
-		Refresh: statusTarget(ctx, conn, id, targets),
+		Refresh: statusTargets(ctx, conn, id, targets, targetIsPendingDeletion),
 		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
-	if out, ok := outputRaw.(*vpclattice.DeregisterTargetsOutput); ok {
+	if out, ok := outputRaw.(*vpclattice.ListTargetsOutput); ok {
 		return out, err
 	}
 
 	return nil, err
 }
 
-func statusTarget(ctx context.Context, conn *vpclattice.Client, id string, targets []interface{}) retry.StateRefreshFunc {
+// statusTargetsFound reports targetsStatusFound as soon as ListTargets succeeds,
+// tolerating not-found in the same way statusTargets does, without regard to any
+// target's health status.
+func statusTargetsFound(ctx context.Context, conn *vpclattice.Client, id string, targets []interface{}) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		out, err := findRegisterTargets(ctx, conn, id, targets)
 		if tfresource.NotFound(err) {
@@ -248,16 +338,50 @@ func statusTarget(ctx context.Context, conn *vpclattice.Client, id string, targe
 			return nil, "", err
 		}
 
-		var status types.TargetStatus
-		if len(out.Items) > 0 {
-			status = out.Items[0].Status
-			return out, string(status), nil
+		return out, targetsStatusFound, nil
+	}
+}
+
+// statusTargets reports a single aggregate refresh state across every target registered
+// to the resource: targetsStatusPending if isPending is true for any of them, and
+// targetsStatusComplete once all of them have settled.
+func statusTargets(ctx context.Context, conn *vpclattice.Client, id string, targets []interface{}, isPending func(types.TargetStatus) bool) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := findRegisterTargets(ctx, conn, id, targets)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
 		}
 
-		return nil, "", err
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, item := range out.Items {
+			if isPending(item.Status) {
+				return out, targetsStatusPending, nil
+			}
+		}
+
+		return out, targetsStatusComplete, nil
 	}
 }
 
+// unhealthyTargetSummaries returns a human readable "id (status)" entry for every target
+// that settled on UNHEALTHY or UNAVAILABLE, so create can surface them in a diagnostic
+// instead of silently succeeding.
+func unhealthyTargetSummaries(apiObjects []types.TargetSummary) []string {
+	var summaries []string
+
+	for _, apiObject := range apiObjects {
+		switch apiObject.Status {
+		case types.TargetStatusUnhealthy, types.TargetStatusUnavailable:
+			summaries = append(summaries, fmt.Sprintf("%s (%s)", aws.ToString(apiObject.Id), apiObject.Status))
+		}
+	}
+
+	return summaries
+}
+
 // Flatten function for targets
 func flattenTargets(apiObjects []types.TargetSummary) []interface{} {
 	if len(apiObjects) == 0 {
@@ -0,0 +1,179 @@
+package vpclattice
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+)
+
+func TestExpandFlattenTargets(t *testing.T) {
+	t.Parallel()
+
+	tfList := []interface{}{
+		map[string]interface{}{
+			"id":   "10.0.0.1",
+			"port": 80,
+		},
+		map[string]interface{}{
+			"id":   "10.0.0.2",
+			"port": 8080,
+		},
+	}
+
+	apiObjects := expandTargets(tfList)
+	if got, want := len(apiObjects), 2; got != want {
+		t.Fatalf("expandTargets() returned %d targets, want %d", got, want)
+	}
+
+	if got, want := aws.ToString(apiObjects[0].Id), "10.0.0.1"; got != want {
+		t.Errorf("apiObjects[0].Id = %s, want %s", got, want)
+	}
+	if got, want := aws.ToInt32(apiObjects[1].Port), int32(8080); got != want {
+		t.Errorf("apiObjects[1].Port = %d, want %d", got, want)
+	}
+
+	summaries := []types.TargetSummary{
+		{Id: aws.String("10.0.0.1"), Port: aws.Int32(80), Status: types.TargetStatusHealthy},
+	}
+
+	got := flattenTargets(summaries)
+	if len(got) != 1 {
+		t.Fatalf("flattenTargets() returned %d entries, want 1", len(got))
+	}
+
+	tfMap := got[0].(map[string]interface{})
+	if tfMap["id"] != "10.0.0.1" {
+		t.Errorf("flattenTargets()[0][\"id\"] = %v, want 10.0.0.1", tfMap["id"])
+	}
+	if tfMap["port"] != int32(80) {
+		t.Errorf("flattenTargets()[0][\"port\"] = %v, want 80", tfMap["port"])
+	}
+}
+
+func TestUnhealthyTargetSummaries(t *testing.T) {
+	t.Parallel()
+
+	apiObjects := []types.TargetSummary{
+		{Id: aws.String("healthy-target"), Status: types.TargetStatusHealthy},
+		{Id: aws.String("unhealthy-target"), Status: types.TargetStatusUnhealthy},
+		{Id: aws.String("unavailable-target"), Status: types.TargetStatusUnavailable},
+		{Id: aws.String("draining-target"), Status: types.TargetStatusDraining},
+	}
+
+	got := unhealthyTargetSummaries(apiObjects)
+	if want := 2; len(got) != want {
+		t.Fatalf("unhealthyTargetSummaries() returned %d entries, want %d", len(got), want)
+	}
+
+	want := []string{
+		"unhealthy-target (UNHEALTHY)",
+		"unavailable-target (UNAVAILABLE)",
+	}
+	for i, w := range want {
+		if got[i] != w {
+			t.Errorf("unhealthyTargetSummaries()[%d] = %s, want %s", i, got[i], w)
+		}
+	}
+}
+
+func TestTargetIsPending(t *testing.T) {
+	t.Parallel()
+
+	allStatuses := types.TargetStatusInitial.Values()
+
+	// expected[waitForStatus][status] = want isPending
+	expected := map[string]map[types.TargetStatus]bool{
+		waitForStatusHealthy: {
+			types.TargetStatusInitial:     true,
+			types.TargetStatusUnhealthy:   true,
+			types.TargetStatusUnavailable: true,
+			types.TargetStatusHealthy:     false,
+			types.TargetStatusUnused:      false,
+			types.TargetStatusDraining:    false,
+		},
+		waitForStatusAny: {
+			types.TargetStatusInitial:     true,
+			types.TargetStatusUnhealthy:   false,
+			types.TargetStatusUnavailable: false,
+			types.TargetStatusHealthy:     false,
+			types.TargetStatusUnused:      false,
+			types.TargetStatusDraining:    false,
+		},
+	}
+
+	for _, waitForStatus := range []string{waitForStatusHealthy, waitForStatusAny} {
+		for _, status := range allStatuses {
+			want, ok := expected[waitForStatus][status]
+			if !ok {
+				t.Fatalf("test is missing an expectation for wait_for_status=%s, status=%s", waitForStatus, status)
+			}
+
+			if got := targetIsPending(waitForStatus, status); got != want {
+				t.Errorf("targetIsPending(%q, %s) = %v, want %v", waitForStatus, status, got, want)
+			}
+		}
+	}
+}
+
+func TestTargetIsPendingDeletion(t *testing.T) {
+	t.Parallel()
+
+	expected := map[types.TargetStatus]bool{
+		types.TargetStatusInitial:     true,
+		types.TargetStatusDraining:    true,
+		types.TargetStatusUnhealthy:   false,
+		types.TargetStatusUnavailable: false,
+		types.TargetStatusHealthy:     false,
+		types.TargetStatusUnused:      false,
+	}
+
+	for _, status := range types.TargetStatusInitial.Values() {
+		want, ok := expected[status]
+		if !ok {
+			t.Fatalf("test is missing an expectation for status=%s", status)
+		}
+
+		if got := targetIsPendingDeletion(status); got != want {
+			t.Errorf("targetIsPendingDeletion(%s) = %v, want %v", status, got, want)
+		}
+	}
+}
+
+func TestTargetIsPending_anySettled(t *testing.T) {
+	t.Parallel()
+
+	// A pure sanity check of the per-target predicate that statusTargets uses for
+	// wait_for_status = "any": HEALTHY and UNHEALTHY count as settled, INITIAL
+	// is still pending. statusTargets itself is not called here.
+	isPending := func(status types.TargetStatus) bool {
+		return targetIsPending(waitForStatusAny, status)
+	}
+
+	settled := []types.TargetStatus{types.TargetStatusHealthy, types.TargetStatusUnhealthy}
+	for _, s := range settled {
+		if isPending(s) {
+			t.Errorf("targetIsPending(any, %s) = true, want false", s)
+		}
+	}
+
+	if !isPending(types.TargetStatusInitial) {
+		t.Error("targetIsPending(any, INITIAL) = false, want true")
+	}
+}
+
+func TestWaitForStatusValues(t *testing.T) {
+	t.Parallel()
+
+	got := waitForStatusValues()
+	want := []string{waitForStatusHealthy, waitForStatusAny, waitForStatusNone}
+
+	if len(got) != len(want) {
+		t.Fatalf("waitForStatusValues() returned %d values, want %d", len(got), len(want))
+	}
+	for i, w := range want {
+		if got[i] != w {
+			t.Errorf("waitForStatusValues()[%d] = %s, want %s", i, got[i], w)
+		}
+	}
+}
@@ -0,0 +1,293 @@
+package vpclattice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Function annotations are used for resource registration to the Provider. DO NOT EDIT.
+// @SDKResource("aws_vpclattice_target_group_attachment", name="Target Group Attachment")
+func ResourceTargetGroupAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTargetGroupAttachmentCreate,
+		ReadWithoutTimeout:   resourceTargetGroupAttachmentRead,
+		DeleteWithoutTimeout: resourceTargetGroupAttachmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTargetGroupAttachmentImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"target_group_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"target_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+const (
+	ResNameTargetGroupAttachment = "Target Group Attachment"
+)
+
+func resourceTargetGroupAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeClient()
+
+	targetGroupIdentifier := d.Get("target_group_identifier").(string)
+	target := expandTarget(map[string]interface{}{
+		"id":   d.Get("target_id").(string),
+		"port": d.Get("port").(int),
+	})
+
+	in := &vpclattice.RegisterTargetsInput{
+		TargetGroupIdentifier: aws.String(targetGroupIdentifier),
+		Targets:               []types.Target{target},
+	}
+
+	out, err := conn.RegisterTargets(ctx, in)
+	if err != nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionCreating, ResNameTargetGroupAttachment, targetGroupIdentifier, err)
+	}
+
+	if out == nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionCreating, ResNameTargetGroupAttachment, targetGroupIdentifier, errors.New("empty output"))
+	}
+
+	d.SetId(targetGroupAttachmentID(targetGroupIdentifier, aws.ToString(target.Id), target.Port))
+
+	if _, err := waitTargetGroupAttachment(ctx, conn, targetGroupIdentifier, target, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionWaitingForCreation, ResNameTargetGroupAttachment, d.Id(), err)
+	}
+
+	return resourceTargetGroupAttachmentRead(ctx, d, meta)
+}
+
+func resourceTargetGroupAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeClient()
+
+	targetGroupIdentifier := d.Get("target_group_identifier").(string)
+	target := expandTarget(map[string]interface{}{
+		"id":   d.Get("target_id").(string),
+		"port": d.Get("port").(int),
+	})
+
+	out, err := findTargetGroupAttachment(ctx, conn, targetGroupIdentifier, target)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VpcLattice TargetGroupAttachment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionReading, ResNameTargetGroupAttachment, d.Id(), err)
+	}
+
+	d.Set("target_group_identifier", targetGroupIdentifier)
+	d.Set("target_id", aws.ToString(out.Id))
+	d.Set("port", aws.ToInt32(out.Port))
+
+	return nil
+}
+
+func resourceTargetGroupAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeClient()
+
+	targetGroupIdentifier := d.Get("target_group_identifier").(string)
+	target := expandTarget(map[string]interface{}{
+		"id":   d.Get("target_id").(string),
+		"port": d.Get("port").(int),
+	})
+
+	log.Printf("[INFO] Deleting VpcLattice TargetGroupAttachment %s", d.Id())
+
+	_, err := conn.DeregisterTargets(ctx, &vpclattice.DeregisterTargetsInput{
+		TargetGroupIdentifier: aws.String(targetGroupIdentifier),
+		Targets:               []types.Target{target},
+	})
+
+	if err != nil {
+		var nfe *types.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return nil
+		}
+
+		return create.DiagError(names.VPCLattice, create.ErrActionDeleting, ResNameTargetGroupAttachment, d.Id(), err)
+	}
+
+	if _, err := waitTargetGroupAttachmentDeleted(ctx, conn, targetGroupIdentifier, target, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionWaitingForDeletion, ResNameTargetGroupAttachment, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceTargetGroupAttachmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	targetGroupIdentifier, targetID, port, err := parseTargetGroupAttachmentID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("target_group_identifier", targetGroupIdentifier)
+	d.Set("target_id", targetID)
+	d.Set("port", port)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// targetGroupAttachmentID builds the 3-part "targetGroupId/targetId/port" ID used to
+// import and uniquely identify a single target's attachment to a target group.
+func targetGroupAttachmentID(targetGroupIdentifier, targetID string, port *int32) string {
+	portPart := ""
+	if port != nil {
+		portPart = strconv.Itoa(int(aws.ToInt32(port)))
+	}
+
+	return strings.Join([]string{targetGroupIdentifier, targetID, portPart}, "/")
+}
+
+// parseTargetGroupAttachmentID splits from the ends rather than doing a flat 3-way
+// split: an ALB target's target_id is the ALB's ARN, which itself contains several
+// "/" characters (e.g. arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/my-alb/50dc6c495c0c9188).
+func parseTargetGroupAttachmentID(id string) (string, string, int, error) {
+	firstIdx := strings.Index(id, "/")
+	lastIdx := strings.LastIndex(id, "/")
+	if firstIdx == -1 || lastIdx == firstIdx {
+		return "", "", 0, fmt.Errorf("unexpected format for ID (%q), expected target-group-identifier/target-id/port", id)
+	}
+
+	targetGroupIdentifier := id[:firstIdx]
+	targetID := id[firstIdx+1 : lastIdx]
+	portPart := id[lastIdx+1:]
+
+	if targetGroupIdentifier == "" || targetID == "" {
+		return "", "", 0, fmt.Errorf("unexpected format for ID (%q), expected target-group-identifier/target-id/port", id)
+	}
+
+	port := 0
+	if portPart != "" {
+		p, err := strconv.Atoi(portPart)
+		if err != nil {
+			return "", "", 0, fmt.Errorf("unexpected format for ID (%q), expected target-group-identifier/target-id/port: %w", id, err)
+		}
+		port = p
+	}
+
+	return targetGroupIdentifier, targetID, port, nil
+}
+
+func findTargetGroupAttachment(ctx context.Context, conn *vpclattice.Client, targetGroupIdentifier string, target types.Target) (*types.TargetSummary, error) {
+	in := &vpclattice.ListTargetsInput{
+		TargetGroupIdentifier: aws.String(targetGroupIdentifier),
+		Targets:               []types.Target{target},
+	}
+
+	out, err := conn.ListTargets(ctx, in)
+	if err != nil {
+		var nfe *types.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil || len(out.Items) == 0 {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	for _, item := range out.Items {
+		if aws.ToString(item.Id) == aws.ToString(target.Id) && aws.ToInt32(item.Port) == aws.ToInt32(target.Port) {
+			return &item, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(in)
+}
+
+func statusTargetGroupAttachment(ctx context.Context, conn *vpclattice.Client, targetGroupIdentifier string, target types.Target) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := findTargetGroupAttachment(ctx, conn, targetGroupIdentifier, target)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.Status), nil
+	}
+}
+
+func waitTargetGroupAttachment(ctx context.Context, conn *vpclattice.Client, targetGroupIdentifier string, target types.Target, timeout time.Duration) (*types.TargetSummary, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(types.TargetStatusInitial),
+		Target:                    enum.Slice(types.TargetStatusHealthy, types.TargetStatusUnhealthy, types.TargetStatusUnused, types.TargetStatusUnavailable),
+		Refresh:                   statusTargetGroupAttachment(ctx, conn, targetGroupIdentifier, target),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*types.TargetSummary); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitTargetGroupAttachmentDeleted(ctx context.Context, conn *vpclattice.Client, targetGroupIdentifier string, target types.Target, timeout time.Duration) (*types.TargetSummary, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.TargetStatusDraining, types.TargetStatusInitial),
+		Target:  []string{},
+		Refresh: statusTargetGroupAttachment(ctx, conn, targetGroupIdentifier, target),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*types.TargetSummary); ok {
+		return out, err
+	}
+
+	return nil, err
+}
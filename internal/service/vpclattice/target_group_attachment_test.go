@@ -0,0 +1,69 @@
+package vpclattice
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+func TestTargetGroupAttachmentID(t *testing.T) {
+	t.Parallel()
+
+	got := targetGroupAttachmentID("tg-0123456789", "10.0.0.1", aws.Int32(80))
+	want := "tg-0123456789/10.0.0.1/80"
+	if got != want {
+		t.Errorf("targetGroupAttachmentID() = %s, want %s", got, want)
+	}
+
+	got = targetGroupAttachmentID("tg-0123456789", "10.0.0.1", nil)
+	want = "tg-0123456789/10.0.0.1/"
+	if got != want {
+		t.Errorf("targetGroupAttachmentID() with nil port = %s, want %s", got, want)
+	}
+}
+
+func TestParseTargetGroupAttachmentID(t *testing.T) {
+	t.Parallel()
+
+	targetGroupIdentifier, targetID, port, err := parseTargetGroupAttachmentID("tg-0123456789/10.0.0.1/80")
+	if err != nil {
+		t.Fatalf("parseTargetGroupAttachmentID() returned unexpected error: %s", err)
+	}
+	if targetGroupIdentifier != "tg-0123456789" {
+		t.Errorf("targetGroupIdentifier = %s, want tg-0123456789", targetGroupIdentifier)
+	}
+	if targetID != "10.0.0.1" {
+		t.Errorf("targetID = %s, want 10.0.0.1", targetID)
+	}
+	if port != 80 {
+		t.Errorf("port = %d, want 80", port)
+	}
+
+	if _, _, _, err := parseTargetGroupAttachmentID("tg-0123456789"); err == nil {
+		t.Error("parseTargetGroupAttachmentID() with too few parts should error")
+	}
+
+	if _, _, _, err := parseTargetGroupAttachmentID("tg-0123456789/10.0.0.1/not-a-port"); err == nil {
+		t.Error("parseTargetGroupAttachmentID() with a non-numeric port should error")
+	}
+}
+
+func TestParseTargetGroupAttachmentID_albARN(t *testing.T) {
+	t.Parallel()
+
+	albARN := "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/my-alb/50dc6c495c0c9188"
+
+	targetGroupIdentifier, targetID, port, err := parseTargetGroupAttachmentID("tg-0123456789/" + albARN + "/80")
+	if err != nil {
+		t.Fatalf("parseTargetGroupAttachmentID() returned unexpected error: %s", err)
+	}
+	if targetGroupIdentifier != "tg-0123456789" {
+		t.Errorf("targetGroupIdentifier = %s, want tg-0123456789", targetGroupIdentifier)
+	}
+	if targetID != albARN {
+		t.Errorf("targetID = %s, want %s", targetID, albARN)
+	}
+	if port != 80 {
+		t.Errorf("port = %d, want 80", port)
+	}
+}
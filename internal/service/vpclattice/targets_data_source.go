@@ -0,0 +1,158 @@
+package vpclattice
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Function annotations are used for data source registration to the Provider. DO NOT EDIT.
+// @SDKDataSource("aws_vpclattice_targets", name="Targets")
+func DataSourceTargets() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTargetsRead,
+
+		Schema: map[string]*schema.Schema{
+			"target_group_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(targetStatusValues(), false),
+			},
+			"targets": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reason_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+const (
+	DSNameTargets = "Targets Data Source"
+)
+
+func targetStatusValues() []string {
+	var values []string
+	for _, s := range types.TargetStatusInitial.Values() {
+		values = append(values, string(s))
+	}
+	return values
+}
+
+func dataSourceTargetsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeClient()
+
+	targetGroupIdentifier := d.Get("target_group_identifier").(string)
+
+	in := &vpclattice.ListTargetsInput{
+		TargetGroupIdentifier: aws.String(targetGroupIdentifier),
+		Targets:               expandTargets(d.Get("targets").([]interface{})),
+	}
+
+	var status types.TargetStatus
+	if v, ok := d.GetOk("status"); ok {
+		status = types.TargetStatus(v.(string))
+	}
+
+	items, err := findTargets(ctx, conn, in, status)
+	if err != nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionReading, DSNameTargets, targetGroupIdentifier, err)
+	}
+
+	d.SetId(id.UniqueId())
+	d.Set("target_group_identifier", targetGroupIdentifier)
+	if err := d.Set("targets", flattenTargetStatuses(items)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting targets: %s", err))
+	}
+
+	return nil
+}
+
+// findTargets paginates through every page of ListTargets for the given input,
+// optionally filtering the results down to a single status client-side.
+func findTargets(ctx context.Context, conn *vpclattice.Client, in *vpclattice.ListTargetsInput, status types.TargetStatus) ([]types.TargetSummary, error) {
+	var items []types.TargetSummary
+
+	paginator := vpclattice.NewListTargetsPaginator(conn, in)
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range out.Items {
+			if status != "" && item.Status != status {
+				continue
+			}
+			items = append(items, item)
+		}
+	}
+
+	return items, nil
+}
+
+func flattenTargetStatuses(apiObjects []types.TargetSummary) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"status": string(apiObject.Status),
+		}
+
+		if v := apiObject.Id; v != nil {
+			tfMap["id"] = aws.ToString(v)
+		}
+
+		if v := apiObject.Port; v != nil {
+			tfMap["port"] = aws.ToInt32(v)
+		}
+
+		if v := apiObject.ReasonCode; v != nil {
+			tfMap["reason_code"] = aws.ToString(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
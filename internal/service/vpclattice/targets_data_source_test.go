@@ -0,0 +1,60 @@
+package vpclattice
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+)
+
+func TestFlattenTargetStatuses(t *testing.T) {
+	t.Parallel()
+
+	apiObjects := []types.TargetSummary{
+		{
+			Id:         aws.String("10.0.0.1"),
+			Port:       aws.Int32(80),
+			Status:     types.TargetStatusHealthy,
+			ReasonCode: aws.String("Target.HealthCheckInProgress"),
+		},
+	}
+
+	got := flattenTargetStatuses(apiObjects)
+	if len(got) != 1 {
+		t.Fatalf("flattenTargetStatuses() returned %d entries, want 1", len(got))
+	}
+
+	tfMap := got[0].(map[string]interface{})
+	if tfMap["id"] != "10.0.0.1" {
+		t.Errorf("flattenTargetStatuses()[0][\"id\"] = %v, want 10.0.0.1", tfMap["id"])
+	}
+	if tfMap["port"] != int32(80) {
+		t.Errorf("flattenTargetStatuses()[0][\"port\"] = %v, want 80", tfMap["port"])
+	}
+	if tfMap["status"] != string(types.TargetStatusHealthy) {
+		t.Errorf("flattenTargetStatuses()[0][\"status\"] = %v, want %s", tfMap["status"], types.TargetStatusHealthy)
+	}
+
+	if got := flattenTargetStatuses(nil); got != nil {
+		t.Errorf("flattenTargetStatuses(nil) = %v, want nil", got)
+	}
+}
+
+func TestTargetStatusValues(t *testing.T) {
+	t.Parallel()
+
+	got := targetStatusValues()
+	if len(got) == 0 {
+		t.Fatal("targetStatusValues() returned no values")
+	}
+
+	var sawHealthy bool
+	for _, v := range got {
+		if v == string(types.TargetStatusHealthy) {
+			sawHealthy = true
+		}
+	}
+	if !sawHealthy {
+		t.Errorf("targetStatusValues() = %v, want it to contain %s", got, types.TargetStatusHealthy)
+	}
+}